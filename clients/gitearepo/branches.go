@@ -0,0 +1,52 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+type branchesHandler struct {
+	client  *gitea.Client
+	repourl *Repo
+}
+
+func (h *branchesHandler) init(client *gitea.Client, repourl *Repo) {
+	h.client = client
+	h.repourl = repourl
+}
+
+func (h *branchesHandler) listBranches() ([]*clients.BranchRef, error) {
+	rawBranches, _, err := h.client.ListRepoBranches(h.repourl.owner, h.repourl.project, gitea.ListRepoBranchesOptions{})
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListRepoBranches: %v", err))
+	}
+
+	branches := make([]*clients.BranchRef, 0, len(rawBranches))
+	for _, rb := range rawBranches {
+		name := rb.Name
+		protected := rb.Protected
+		branches = append(branches, &clients.BranchRef{
+			Name:      &name,
+			Protected: &protected,
+		})
+	}
+	return branches, nil
+}
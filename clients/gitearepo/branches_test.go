@@ -0,0 +1,51 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+func TestListBranches(t *testing.T) {
+	t.Parallel()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]gitea.Branch{
+			{Name: "main", Protected: true},
+			{Name: "dev", Protected: false},
+		})
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := branchesHandler{}
+	h.init(client, repo)
+
+	branches, err := h.listBranches()
+	if err != nil {
+		t.Fatalf("listBranches: %v", err)
+	}
+	if len(branches) != 2 {
+		t.Fatalf("listBranches() returned %d branches, want 2", len(branches))
+	}
+	if *branches[0].Name != "main" || !*branches[0].Protected {
+		t.Errorf("branches[0] = %+v, want main/protected", branches[0])
+	}
+	if *branches[1].Name != "dev" || *branches[1].Protected {
+		t.Errorf("branches[1] = %+v, want dev/unprotected", branches[1])
+	}
+}
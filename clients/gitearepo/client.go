@@ -0,0 +1,170 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gitearepo implements clients.RepoClient for Gitea and Forgejo
+// repositories. Gitea and Forgejo expose the same REST API surface, so this
+// single client handles both.
+//
+// Requires code.gitea.io/sdk/gitea >= v0.25.1 (go.mod: add
+// "code.gitea.io/sdk/gitea v0.25.1" to require and run `go mod tidy`).
+package gitearepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+	sclog "github.com/ossf/scorecard/v5/log"
+)
+
+// errInputRepoType indicates that the a repo client was expecting a different repo client type.
+var errInputRepoType = errors.New("input repo should be of type Repo")
+
+// Client implements clients.RepoClient for Gitea/Forgejo repositories.
+type Client struct {
+	repourl     *Repo
+	repo        *gitea.Repository
+	client      *gitea.Client
+	logger      *sclog.Logger
+	ctx         context.Context
+	commitDepth int
+	commits     commitsHandler
+	releases    releasesHandler
+	branches    branchesHandler
+	workflows   workflowsHandler
+	issues      issuesHandler
+	file        fileHandler
+}
+
+// InitRepo implements clients.RepoClient.InitRepo.
+func (c *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth int) error {
+	repourl, ok := inputRepo.(*Repo)
+	if !ok {
+		return errInputRepoType
+	}
+	repourl.commitSHA = commitSHA
+	c.repourl = repourl
+	c.commitDepth = commitDepth
+
+	baseURL := fmt.Sprintf("%s://%s", scheme(repourl.scheme), repourl.host)
+	client, err := gitea.NewClient(baseURL, gitea.SetContext(c.ctx))
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("gitea.NewClient: %v", err))
+	}
+	c.client = client
+
+	repo, _, err := client.GetRepo(repourl.owner, repourl.project)
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetRepo: %v", err))
+	}
+	c.repo = repo
+	if repourl.defaultBranch == "" {
+		repourl.defaultBranch = repo.DefaultBranch
+	}
+	if c.logger != nil {
+		c.logger.Info(fmt.Sprintf("initialized gitea client for %s", repourl.URI()))
+	}
+
+	c.commits.init(c.client, repourl)
+	c.releases.init(c.client, repourl)
+	c.branches.init(c.client, repourl)
+	c.workflows.init(c.client, repourl)
+	c.issues.init(c.client, repourl)
+	c.file.init(c.client, repourl, repourl.commitSHA)
+	return nil
+}
+
+func scheme(s string) string {
+	if s == "" {
+		return "https"
+	}
+	return s
+}
+
+// isNotFoundOrDisabled reports whether resp is a 404, which the Actions and
+// Issues APIs return when the feature is disabled on a given repo rather than
+// when the repo itself is missing. The gitea SDK doesn't export a sentinel
+// error for this, so the HTTP status on the response is the only signal.
+func isNotFoundOrDisabled(resp *gitea.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
+// URI implements clients.RepoClient.URI.
+func (c *Client) URI() string {
+	return c.repourl.URI()
+}
+
+// IsArchived implements clients.RepoClient.IsArchived.
+func (c *Client) IsArchived() (bool, error) {
+	return c.repo.Archived, nil
+}
+
+// GetDefaultBranchName implements clients.RepoClient.GetDefaultBranchName.
+func (c *Client) GetDefaultBranchName() (string, error) {
+	return c.repourl.defaultBranch, nil
+}
+
+// ListCommits implements clients.RepoClient.ListCommits.
+func (c *Client) ListCommits() ([]clients.Commit, error) {
+	return c.commits.listCommits()
+}
+
+// ListReleases implements clients.RepoClient.ListReleases.
+func (c *Client) ListReleases() ([]clients.Release, error) {
+	return c.releases.listReleases()
+}
+
+// ListBranches implements clients.RepoClient.ListBranches.
+func (c *Client) ListBranches() ([]*clients.BranchRef, error) {
+	return c.branches.listBranches()
+}
+
+// ListWorkflowRuns implements clients.RepoClient.ListWorkflowRuns.
+func (c *Client) ListWorkflowRuns() ([]clients.WorkflowRun, error) {
+	return c.workflows.listWorkflowRuns()
+}
+
+// ListIssues implements clients.RepoClient.ListIssues.
+func (c *Client) ListIssues() ([]clients.Issue, error) {
+	return c.issues.listIssues()
+}
+
+// GetFileReader implements clients.RepoClient.GetFileReader.
+func (c *Client) GetFileReader(filename string) (io.ReadCloser, error) {
+	return c.file.getFileReader(filename)
+}
+
+// ListFiles implements clients.RepoClient.ListFiles.
+func (c *Client) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	return c.file.listFiles(predicate)
+}
+
+// Close implements clients.RepoClient.Close.
+func (c *Client) Close() error {
+	return nil
+}
+
+// CreateGiteaRepoClient returns a RepoClient implementation for Gitea/Forgejo.
+func CreateGiteaRepoClient(ctx context.Context, logger *sclog.Logger) clients.RepoClient {
+	return &Client{
+		ctx:    ctx,
+		logger: logger,
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+type commitsHandler struct {
+	client  *gitea.Client
+	repourl *Repo
+}
+
+func (h *commitsHandler) init(client *gitea.Client, repourl *Repo) {
+	h.client = client
+	h.repourl = repourl
+}
+
+func (h *commitsHandler) listCommits() ([]clients.Commit, error) {
+	opts := gitea.ListCommitOptions{
+		SHA: h.repourl.commitSHA,
+	}
+	rawCommits, _, err := h.client.ListRepoCommits(h.repourl.owner, h.repourl.project, opts)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListRepoCommits: %v", err))
+	}
+
+	commits := make([]clients.Commit, 0, len(rawCommits))
+	for _, rc := range rawCommits {
+		commits = append(commits, clients.Commit{
+			SHA:           rc.SHA,
+			CommittedDate: rc.Created,
+			Message:       rc.RepoCommit.Message,
+		})
+	}
+	return commits, nil
+}
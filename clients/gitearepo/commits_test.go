@@ -0,0 +1,63 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+func TestListCommits(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]gitea.Commit{
+			{
+				CommitMeta: &gitea.CommitMeta{
+					SHA:     "deadbeef",
+					Created: created,
+				},
+				RepoCommit: &gitea.RepoCommit{
+					Message: "fix: a bug\n",
+				},
+			},
+		})
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := commitsHandler{}
+	h.init(client, repo)
+
+	commits, err := h.listCommits()
+	if err != nil {
+		t.Fatalf("listCommits: %v", err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("listCommits() returned %d commits, want 1", len(commits))
+	}
+	if commits[0].SHA != "deadbeef" {
+		t.Errorf("commits[0].SHA = %q, want %q", commits[0].SHA, "deadbeef")
+	}
+	if commits[0].Message != "fix: a bug\n" {
+		t.Errorf("commits[0].Message = %q, want %q", commits[0].Message, "fix: a bug\n")
+	}
+	if !commits[0].CommittedDate.Equal(created) {
+		t.Errorf("commits[0].CommittedDate = %v, want %v", commits[0].CommittedDate, created)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"code.gitea.io/sdk/gitea"
+
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+type fileHandler struct {
+	client    *gitea.Client
+	repourl   *Repo
+	commitSHA string
+}
+
+func (h *fileHandler) init(client *gitea.Client, repourl *Repo, commitSHA string) {
+	h.client = client
+	h.repourl = repourl
+	h.commitSHA = commitSHA
+}
+
+func (h *fileHandler) getFileReader(filename string) (io.ReadCloser, error) {
+	ref := h.commitSHA
+	if ref == "" {
+		ref = h.repourl.defaultBranch
+	}
+	raw, _, err := h.client.GetFile(h.repourl.owner, h.repourl.project, ref, filename)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetFile: %v", err))
+	}
+	return io.NopCloser(bytes.NewReader(raw)), nil
+}
+
+func (h *fileHandler) listFiles(predicate func(string) (bool, error)) ([]string, error) {
+	tree, _, err := h.client.GetTrees(h.repourl.owner, h.repourl.project, gitea.ListTreeOptions{
+		Ref:       h.commitSHA,
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetTrees: %v", err))
+	}
+
+	var files []string
+	for _, entry := range tree.Entries {
+		if entry.Type != "blob" {
+			continue
+		}
+		matches, err := predicate(entry.Path)
+		if err != nil {
+			return nil, fmt.Errorf("predicate on %q: %w", entry.Path, err)
+		}
+		if matches {
+			files = append(files, entry.Path)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+func TestGetFileReader(t *testing.T) {
+	t.Parallel()
+	const want = "module github.com/ossf/scorecard/v5\n"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, want)
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := fileHandler{}
+	h.init(client, repo, "main")
+
+	rc, err := h.getFileReader("go.mod")
+	if err != nil {
+		t.Fatalf("getFileReader: %v", err)
+	}
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("reading file contents: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("getFileReader content = %q, want %q", got, want)
+	}
+}
+
+func TestListFiles(t *testing.T) {
+	t.Parallel()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(gitea.GitTreeResponse{
+			Entries: []gitea.GitEntry{
+				{Path: "go.mod", Type: "blob"},
+				{Path: "README.md", Type: "blob"},
+				{Path: "pkg", Type: "tree"},
+			},
+		})
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := fileHandler{}
+	h.init(client, repo, "main")
+
+	files, err := h.listFiles(func(name string) (bool, error) {
+		return strings.HasSuffix(name, ".mod"), nil
+	})
+	if err != nil {
+		t.Fatalf("listFiles: %v", err)
+	}
+	if len(files) != 1 || files[0] != "go.mod" {
+		t.Errorf("listFiles() = %v, want [go.mod]", files)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+type issuesHandler struct {
+	client  *gitea.Client
+	repourl *Repo
+}
+
+func (h *issuesHandler) init(client *gitea.Client, repourl *Repo) {
+	h.client = client
+	h.repourl = repourl
+}
+
+func (h *issuesHandler) listIssues() ([]clients.Issue, error) {
+	rawIssues, resp, err := h.client.ListRepoIssues(h.repourl.owner, h.repourl.project, gitea.ListIssueOption{
+		Type: gitea.IssueTypeIssue,
+	})
+	if err != nil {
+		if isNotFoundOrDisabled(resp) {
+			return nil, nil
+		}
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListRepoIssues: %v", err))
+	}
+
+	issues := make([]clients.Issue, 0, len(rawIssues))
+	for _, ri := range rawIssues {
+		uri := fmt.Sprintf("%s/%s/%s/issues/%d", h.repourl.host, h.repourl.owner, h.repourl.project, ri.Index)
+		createdAt := ri.Created
+		issue := clients.Issue{
+			URI:       &uri,
+			CreatedAt: &createdAt,
+		}
+		if ri.Poster != nil {
+			login := ri.Poster.UserName
+			issue.Author = &clients.User{Login: login}
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
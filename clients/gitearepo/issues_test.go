@@ -0,0 +1,75 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+func TestListIssues(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]gitea.Issue{
+			{
+				Index:   7,
+				Created: created,
+				Poster:  &gitea.User{UserName: "alice"},
+			},
+		})
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := issuesHandler{}
+	h.init(client, repo)
+
+	issues, err := h.listIssues()
+	if err != nil {
+		t.Fatalf("listIssues: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("listIssues() returned %d issues, want 1", len(issues))
+	}
+	if issues[0].Author == nil || issues[0].Author.Login != "alice" {
+		t.Errorf("issues[0].Author = %+v, want login alice", issues[0].Author)
+	}
+	if issues[0].CreatedAt == nil || !issues[0].CreatedAt.Equal(created) {
+		t.Errorf("issues[0].CreatedAt = %v, want %v", issues[0].CreatedAt, created)
+	}
+}
+
+func TestListIssuesDisabled(t *testing.T) {
+	t.Parallel()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := issuesHandler{}
+	h.init(client, repo)
+
+	issues, err := h.listIssues()
+	if err != nil {
+		t.Fatalf("listIssues() on a disabled-issues repo returned err: %v, want nil", err)
+	}
+	if issues != nil {
+		t.Errorf("listIssues() = %+v, want nil", issues)
+	}
+}
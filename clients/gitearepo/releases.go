@@ -0,0 +1,57 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+type releasesHandler struct {
+	client  *gitea.Client
+	repourl *Repo
+}
+
+func (h *releasesHandler) init(client *gitea.Client, repourl *Repo) {
+	h.client = client
+	h.repourl = repourl
+}
+
+func (h *releasesHandler) listReleases() ([]clients.Release, error) {
+	rawReleases, _, err := h.client.ListReleases(h.repourl.owner, h.repourl.project, gitea.ListReleasesOptions{})
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListReleases: %v", err))
+	}
+
+	releases := make([]clients.Release, 0, len(rawReleases))
+	for _, rr := range rawReleases {
+		release := clients.Release{
+			TagName: rr.TagName,
+			URL:     rr.URL,
+		}
+		for _, a := range rr.Attachments {
+			release.Assets = append(release.Assets, clients.ReleaseAsset{
+				Name: a.Name,
+				URL:  a.DownloadURL,
+			})
+		}
+		releases = append(releases, release)
+	}
+	return releases, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+func TestListReleases(t *testing.T) {
+	t.Parallel()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]gitea.Release{
+			{
+				TagName: "v1.0.0",
+				URL:     "https://gitea.example/o/r/releases/v1.0.0",
+				Attachments: []*gitea.Attachment{
+					{Name: "asset.tar.gz", DownloadURL: "https://gitea.example/o/r/releases/download/v1.0.0/asset.tar.gz"},
+				},
+			},
+		})
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := releasesHandler{}
+	h.init(client, repo)
+
+	releases, err := h.listReleases()
+	if err != nil {
+		t.Fatalf("listReleases: %v", err)
+	}
+	if len(releases) != 1 {
+		t.Fatalf("listReleases() returned %d releases, want 1", len(releases))
+	}
+	if releases[0].TagName != "v1.0.0" {
+		t.Errorf("releases[0].TagName = %q, want %q", releases[0].TagName, "v1.0.0")
+	}
+	if len(releases[0].Assets) != 1 || releases[0].Assets[0].Name != "asset.tar.gz" {
+		t.Errorf("releases[0].Assets = %+v, want one asset named asset.tar.gz", releases[0].Assets)
+	}
+}
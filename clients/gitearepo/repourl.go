@@ -0,0 +1,98 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// Repo identifies a Gitea or Forgejo repository. Gitea and Forgejo share
+// the same REST API surface, so a single implementation serves both.
+type Repo struct {
+	scheme        string
+	host          string
+	owner         string
+	project       string
+	defaultBranch string
+	commitSHA     string
+	metadata      []string
+}
+
+// IsValid implements clients.Repo.IsValid.
+func (r *Repo) IsValid() error {
+	if strings.TrimSpace(r.owner) == "" || strings.TrimSpace(r.project) == "" {
+		return sce.WithMessage(sce.ErrorInvalidURL,
+			fmt.Sprintf("%v. Expected the full repository url", r.URI()))
+	}
+	return nil
+}
+
+// String implements Stringer for Repo.
+func (r *Repo) String() string {
+	return fmt.Sprintf("gitea-repo:%s", r.URI())
+}
+
+// URI implements clients.Repo.URI.
+func (r *Repo) URI() string {
+	return fmt.Sprintf("%s/%s/%s", r.host, r.owner, r.project)
+}
+
+// Host implements clients.Repo.Host.
+func (r *Repo) Host() string {
+	return r.host
+}
+
+// AppendMetadata implements clients.Repo.AppendMetadata.
+func (r *Repo) AppendMetadata(metadata ...string) {
+	r.metadata = append(r.metadata, metadata...)
+}
+
+// Metadata implements clients.Repo.Metadata.
+func (r *Repo) Metadata() []string {
+	return r.metadata
+}
+
+// MakeGiteaRepo returns a clients.Repo for a Gitea or Forgejo repository URI,
+// e.g. "gitea.com/owner/repo" or "https://codeberg.org/owner/repo".
+func MakeGiteaRepo(fullURL string) (clients.Repo, error) {
+	var t Repo
+	const sepLength = 3
+
+	trimmed := fullURL
+	for _, prefix := range []string{"https://", "http://"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			t.scheme = strings.TrimSuffix(prefix, "://")
+			trimmed = strings.TrimPrefix(trimmed, prefix)
+			break
+		}
+	}
+
+	c := strings.Split(trimmed, "/")
+	if len(c) != sepLength {
+		return nil, sce.WithMessage(sce.ErrorInvalidURL,
+			fmt.Sprintf("invalid repo flag: %v. Expected the full repository url", fullURL))
+	}
+	t.host, t.owner, t.project = c[0], c[1], c[2]
+	t.project = strings.TrimSuffix(t.project, ".git")
+
+	if err := t.IsValid(); err != nil {
+		return nil, fmt.Errorf("error in IsValid: %w", err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import "testing"
+
+func TestMakeGiteaRepo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "gitea.com repo",
+			uri:  "gitea.com/ossf/scorecard",
+			want: "gitea.com/ossf/scorecard",
+		},
+		{
+			name: "self-hosted forgejo instance",
+			uri:  "codeberg.org/ossf/scorecard",
+			want: "codeberg.org/ossf/scorecard",
+		},
+		{
+			name: "https scheme is stripped",
+			uri:  "https://codeberg.org/ossf/scorecard",
+			want: "codeberg.org/ossf/scorecard",
+		},
+		{
+			name: "strips .git suffix",
+			uri:  "codeberg.org/ossf/scorecard.git",
+			want: "codeberg.org/ossf/scorecard",
+		},
+		{
+			name:    "missing project",
+			uri:     "codeberg.org/ossf",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := MakeGiteaRepo(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MakeGiteaRepo(%q) error = %v, wantErr %v", tt.uri, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if r.URI() != tt.want {
+				t.Errorf("MakeGiteaRepo(%q).URI() = %q, want %q", tt.uri, r.URI(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// newTestClient spins up an httptest.Server driven by handler and returns a
+// gitea.Client pointed at it. gitea.SetGiteaVersion skips the server-version
+// handshake NewClient would otherwise perform against the real instance.
+func newTestClient(t *testing.T, handler http.Handler) (*gitea.Client, *Repo) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client, err := gitea.NewClient(srv.URL, gitea.SetGiteaVersion("1.20.0"))
+	if err != nil {
+		t.Fatalf("gitea.NewClient: %v", err)
+	}
+	return client, &Repo{host: "gitea.example", owner: "o", project: "r"}
+}
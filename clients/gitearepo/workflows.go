@@ -0,0 +1,60 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+type workflowsHandler struct {
+	client  *gitea.Client
+	repourl *Repo
+}
+
+func (h *workflowsHandler) init(client *gitea.Client, repourl *Repo) {
+	h.client = client
+	h.repourl = repourl
+}
+
+// listWorkflowRuns returns the repo's Gitea/Forgejo Actions runs. Instances
+// without Actions enabled report no runs rather than an error, matching how
+// the GitHub client treats repos with Actions disabled.
+//
+// Requires code.gitea.io/sdk/gitea >= v0.25.1, the first release carrying
+// Client.ListRepoActionTasks; earlier versions have no Actions API at all.
+func (h *workflowsHandler) listWorkflowRuns() ([]clients.WorkflowRun, error) {
+	taskResp, resp, err := h.client.ListRepoActionTasks(h.repourl.owner, h.repourl.project, gitea.ListOptions{})
+	if err != nil {
+		if isNotFoundOrDisabled(resp) {
+			return nil, nil
+		}
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListRepoActionTasks: %v", err))
+	}
+
+	runs := make([]clients.WorkflowRun, 0, len(taskResp.WorkflowRuns))
+	for _, t := range taskResp.WorkflowRuns {
+		headSHA := t.HeadSHA
+		runs = append(runs, clients.WorkflowRun{
+			HeadSHA: &headSHA,
+			URL:     t.URL,
+		})
+	}
+	return runs, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+func TestListWorkflowRuns(t *testing.T) {
+	t.Parallel()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(gitea.ActionTaskResponse{
+			WorkflowRuns: []*gitea.ActionTask{
+				{HeadSHA: "deadbeef", URL: "https://gitea.example/o/r/actions/runs/1"},
+			},
+		})
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := workflowsHandler{}
+	h.init(client, repo)
+
+	runs, err := h.listWorkflowRuns()
+	if err != nil {
+		t.Fatalf("listWorkflowRuns: %v", err)
+	}
+	if len(runs) != 1 || *runs[0].HeadSHA != "deadbeef" {
+		t.Errorf("listWorkflowRuns() = %+v, want one run with HeadSHA deadbeef", runs)
+	}
+}
+
+func TestListWorkflowRunsDisabled(t *testing.T) {
+	t.Parallel()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	client, repo := newTestClient(t, handler)
+
+	h := workflowsHandler{}
+	h.init(client, repo)
+
+	runs, err := h.listWorkflowRuns()
+	if err != nil {
+		t.Fatalf("listWorkflowRuns() on a repo without Actions returned err: %v, want nil", err)
+	}
+	if runs != nil {
+		t.Errorf("listWorkflowRuns() = %+v, want nil", runs)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errors defines the sentinel errors shared across Scorecard's
+// clients, checks and runner.
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrScorecardInternal indicates an internal error, e.g. an unexpected
+	// condition that the caller cannot be expected to recover from.
+	ErrScorecardInternal = errors.New("internal error")
+	// ErrorInvalidURL indicates the repo flag/URL given by the user was invalid.
+	ErrorInvalidURL = errors.New("invalid repo flag")
+	// ErrorUnsupportedHost indicates a repo URL's host isn't supported by the client.
+	ErrorUnsupportedHost = errors.New("unsupported host")
+	// ErrScorecardTimeout indicates a check (or probe) exceeded its configured
+	// time budget and was abandoned rather than awaited to completion.
+	ErrScorecardTimeout = errors.New("check timed out")
+)
+
+// WithMessage wraps a sentinel error with additional context, in the style
+// expected by callers matching on errors.Is(err, <sentinel>).
+func WithMessage(e error, message string) error {
+	if message == "" {
+		return e
+	}
+	return fmt.Errorf("%w: %s", e, message)
+}
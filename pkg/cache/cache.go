@@ -0,0 +1,37 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a pluggable ResultCache that runScorecard consults
+// before dispatching a check, so repeated runs against the same commit (CI
+// matrix jobs, the weekly cron scanner) can skip network-heavy checks like
+// Vulnerabilities and Fuzzing entirely.
+package cache
+
+import "github.com/ossf/scorecard/v5/checker"
+
+// ResultCache stores completed checker.CheckResults keyed by the repo they
+// were computed for, the commit they're valid at, and the check name.
+// Implementations must be safe for concurrent use: runEnabledChecks calls
+// Get and Put from multiple check goroutines at once.
+type ResultCache interface {
+	// Get returns the cached result for (repoURI, sha, checkName), and
+	// whether it was found.
+	Get(repoURI, sha, checkName string) (checker.CheckResult, bool)
+	// Put stores result for (repoURI, sha, checkName).
+	Put(repoURI, sha, checkName string, result checker.CheckResult) error
+}
+
+func key(repoURI, sha, checkName string) string {
+	return repoURI + "@" + sha + "/" + checkName
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+// FSCache is a ResultCache backed by one JSON file per (repo, sha, check)
+// under baseDir, so results survive across process restarts (e.g. between
+// matrix jobs on the same CI runner, or the weekly cron scanner's pod).
+type FSCache struct {
+	baseDir string
+}
+
+// NewFSCache returns an FSCache rooted at baseDir, creating it if needed.
+func NewFSCache(baseDir string) (*FSCache, error) {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating cache dir %q: %w", baseDir, err)
+	}
+	return &FSCache{baseDir: baseDir}, nil
+}
+
+func (c *FSCache) path(repoURI, sha, checkName string) string {
+	repoHash := sha256.Sum256([]byte(repoURI))
+	return filepath.Join(c.baseDir, hex.EncodeToString(repoHash[:]), sha, checkName+".json")
+}
+
+// Get implements ResultCache.Get.
+func (c *FSCache) Get(repoURI, sha, checkName string) (checker.CheckResult, bool) {
+	data, err := os.ReadFile(c.path(repoURI, sha, checkName))
+	if err != nil {
+		return checker.CheckResult{}, false
+	}
+
+	var result checker.CheckResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return checker.CheckResult{}, false
+	}
+	return result, true
+}
+
+// Put implements ResultCache.Put.
+func (c *FSCache) Put(repoURI, sha, checkName string, result checker.CheckResult) error {
+	p := c.path(repoURI, sha, checkName)
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return fmt.Errorf("creating cache dir for %q: %w", p, err)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("marshaling result for %q/%s: %w", repoURI, checkName, err)
+	}
+	if err := os.WriteFile(p, data, 0o644); err != nil {
+		return fmt.Errorf("writing cache file %q: %w", p, err)
+	}
+	return nil
+}
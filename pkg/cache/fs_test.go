@@ -0,0 +1,81 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestFSCacheGetPut(t *testing.T) {
+	t.Parallel()
+	c, err := NewFSCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFSCache: %v", err)
+	}
+
+	if _, ok := c.Get("github.com/o/r", "sha1", "Fuzzing"); ok {
+		t.Fatalf("Get on empty cache returned ok=true")
+	}
+
+	want := checker.CheckResult{Name: "Fuzzing", Score: 7}
+	if err := c.Put("github.com/o/r", "sha1", "Fuzzing", want); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	got, ok := c.Get("github.com/o/r", "sha1", "Fuzzing")
+	if !ok {
+		t.Fatalf("Get after Put returned ok=false")
+	}
+	if got.Score != want.Score {
+		t.Errorf("Get().Score = %d, want %d", got.Score, want.Score)
+	}
+}
+
+func TestFSCacheGetMiss(t *testing.T) {
+	t.Parallel()
+	c, err := NewFSCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFSCache: %v", err)
+	}
+
+	if _, ok := c.Get("github.com/o/r", "does-not-exist", "Fuzzing"); ok {
+		t.Errorf("Get for an uncached sha returned ok=true")
+	}
+}
+
+func TestFSCacheGetCorruptFile(t *testing.T) {
+	t.Parallel()
+	baseDir := t.TempDir()
+	c, err := NewFSCache(baseDir)
+	if err != nil {
+		t.Fatalf("NewFSCache: %v", err)
+	}
+
+	p := c.path("github.com/o/r", "sha1", "Fuzzing")
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := os.WriteFile(p, []byte("not json"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, ok := c.Get("github.com/o/r", "sha1", "Fuzzing"); ok {
+		t.Errorf("Get on a corrupt cache file returned ok=true, want false")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+// defaultLRUCapacity bounds an LRUCache created without an explicit capacity.
+const defaultLRUCapacity = 1000
+
+// LRUCache is an in-memory, process-local ResultCache that evicts the least
+// recently used entry once it exceeds its capacity.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type lruEntry struct {
+	key    string
+	result checker.CheckResult
+}
+
+// NewLRUCache returns an LRUCache holding at most capacity results. A
+// non-positive capacity falls back to defaultLRUCapacity.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		capacity = defaultLRUCapacity
+	}
+	return &LRUCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get implements ResultCache.Get.
+func (c *LRUCache) Get(repoURI, sha, checkName string) (checker.CheckResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key(repoURI, sha, checkName)]
+	if !ok {
+		return checker.CheckResult{}, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).result, true
+}
+
+// Put implements ResultCache.Put.
+func (c *LRUCache) Put(repoURI, sha, checkName string, result checker.CheckResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := key(repoURI, sha, checkName)
+	if elem, ok := c.entries[k]; ok {
+		elem.Value.(*lruEntry).result = result
+		c.order.MoveToFront(elem)
+		return nil
+	}
+
+	elem := c.order.PushFront(&lruEntry{key: k, result: result})
+	c.entries[k] = elem
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*lruEntry).key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestLRUCacheGetPut(t *testing.T) {
+	t.Parallel()
+	c := NewLRUCache(2)
+
+	if _, ok := c.Get("github.com/o/r", "sha1", "Fuzzing"); ok {
+		t.Fatalf("Get on empty cache returned ok=true")
+	}
+
+	want := checker.CheckResult{Name: "Fuzzing", Score: 7}
+	if err := c.Put("github.com/o/r", "sha1", "Fuzzing", want); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	got, ok := c.Get("github.com/o/r", "sha1", "Fuzzing")
+	if !ok {
+		t.Fatalf("Get after Put returned ok=false")
+	}
+	if got.Score != want.Score {
+		t.Errorf("Get().Score = %d, want %d", got.Score, want.Score)
+	}
+}
+
+func TestLRUCacheEvictsOldest(t *testing.T) {
+	t.Parallel()
+	c := NewLRUCache(1)
+
+	_ = c.Put("github.com/o/r", "sha1", "Fuzzing", checker.CheckResult{Name: "Fuzzing"})
+	_ = c.Put("github.com/o/r", "sha1", "Vulnerabilities", checker.CheckResult{Name: "Vulnerabilities"})
+
+	if _, ok := c.Get("github.com/o/r", "sha1", "Fuzzing"); ok {
+		t.Errorf("oldest entry should have been evicted")
+	}
+	if _, ok := c.Get("github.com/o/r", "sha1", "Vulnerabilities"); !ok {
+		t.Errorf("most recently put entry should still be cached")
+	}
+}
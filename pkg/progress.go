@@ -0,0 +1,79 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"errors"
+	"time"
+
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// ProgressStage identifies where in a check's (or probe's) lifecycle a
+// ProgressEvent was emitted.
+type ProgressStage int
+
+const (
+	// CheckStarted is emitted right before a check's goroutine begins running.
+	CheckStarted ProgressStage = iota
+	// CheckFinished is emitted once a check (or probe) has produced a result.
+	CheckFinished
+)
+
+// ProgressEvent describes the lifecycle of a single check or probe run, for
+// callers embedding Scorecard as a library (dashboards, CI UIs) that want to
+// observe progress without waiting on the final ScorecardResult.
+type ProgressEvent struct {
+	// CheckName is the check (or probe) the event pertains to.
+	CheckName string
+	Stage     ProgressStage
+	// Elapsed is unset on CheckStarted and populated on CheckFinished.
+	Elapsed time.Duration
+	// Err is the error the check finished with, if any.
+	Err error
+	// Score is the check's partial score, valid only on CheckFinished.
+	Score int
+}
+
+// TimedOut reports whether the event's Err is the check (or probe) exceeding
+// its time budget, as distinct from any other check error. JSON/SARIF result
+// serializers should call this (or errors.Is(result.Error, sce.ErrScorecardTimeout)
+// directly on the corresponding checker.CheckResult) to report a "timed out"
+// status rather than folding every non-nil error into a generic one.
+func (e ProgressEvent) TimedOut() bool {
+	return IsCheckTimeout(e.Err)
+}
+
+// IsCheckTimeout reports whether err is (or wraps) sce.ErrScorecardTimeout,
+// i.e. the check was abandoned for exceeding WithCheckTimeout/WithTotalTimeout
+// rather than failing for another reason.
+func IsCheckTimeout(err error) bool {
+	return errors.Is(err, sce.ErrScorecardTimeout)
+}
+
+// progressSink emits ProgressEvents without blocking the caller. A slow or
+// absent consumer must never stall the check/probe goroutines, so events are
+// dropped rather than queued when the channel isn't ready to receive.
+type progressSink chan<- ProgressEvent
+
+func (s progressSink) emit(event ProgressEvent) {
+	if s == nil {
+		return
+	}
+	select {
+	case s <- event:
+	default:
+	}
+}
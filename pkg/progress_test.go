@@ -0,0 +1,83 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+func TestProgressSinkEmitNilIsNoop(t *testing.T) {
+	t.Parallel()
+	var sink progressSink
+	// Must not panic or block on a nil sink.
+	sink.emit(ProgressEvent{CheckName: "Fuzzing", Stage: CheckStarted})
+}
+
+func TestProgressSinkEmitDoesNotBlockOnFullChannel(t *testing.T) {
+	t.Parallel()
+	ch := make(chan ProgressEvent) // unbuffered, nobody is reading
+	sink := progressSink(ch)
+
+	done := make(chan struct{})
+	go func() {
+		sink.emit(ProgressEvent{CheckName: "Fuzzing", Stage: CheckStarted})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("emit blocked on a channel nobody was reading from")
+	}
+}
+
+func TestProgressSinkEmitDeliversToReadyConsumer(t *testing.T) {
+	t.Parallel()
+	ch := make(chan ProgressEvent, 1)
+	sink := progressSink(ch)
+
+	sink.emit(ProgressEvent{CheckName: "Fuzzing", Stage: CheckFinished, Score: 5})
+
+	select {
+	case ev := <-ch:
+		if ev.CheckName != "Fuzzing" || ev.Score != 5 {
+			t.Errorf("got %+v, want CheckName=Fuzzing Score=5", ev)
+		}
+	default:
+		t.Fatal("expected an event on the channel, got none")
+	}
+}
+
+func TestProgressEventTimedOut(t *testing.T) {
+	t.Parallel()
+	timedOut := ProgressEvent{Err: sce.WithMessage(sce.ErrScorecardTimeout, "check exceeded 5s budget")}
+	if !timedOut.TimedOut() {
+		t.Errorf("TimedOut() = false for a wrapped ErrScorecardTimeout, want true")
+	}
+
+	other := ProgressEvent{Err: errors.New("some other failure")}
+	if other.TimedOut() {
+		t.Errorf("TimedOut() = true for an unrelated error, want false")
+	}
+
+	clean := ProgressEvent{}
+	if clean.TimedOut() {
+		t.Errorf("TimedOut() = true for a nil error, want false")
+	}
+}
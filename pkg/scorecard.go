@@ -29,6 +29,7 @@ import (
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/gitearepo"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/clients/localdir"
@@ -40,6 +41,7 @@ import (
 	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
 	sclog "github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/cache"
 	"github.com/ossf/scorecard/v5/policy"
 )
 
@@ -48,9 +50,13 @@ var errEmptyRepository = errors.New("repository empty")
 
 func runEnabledChecks(ctx context.Context,
 	repo clients.Repo,
+	commitSHA string,
 	request *checker.CheckRequest,
 	checksToRun checker.CheckNameToFnMap,
 	resultsCh chan<- checker.CheckResult,
+	progress progressSink,
+	checkTimeout time.Duration,
+	resultCache cache.ResultCache,
 ) {
 	wg := sync.WaitGroup{}
 	for checkName, checkFn := range checksToRun {
@@ -59,19 +65,95 @@ func runEnabledChecks(ctx context.Context,
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+
+			start := time.Now()
+			if resultCache != nil {
+				if cached, ok := resultCache.Get(repo.URI(), commitSHA, checkName); ok {
+					progress.emit(ProgressEvent{CheckName: checkName, Stage: CheckStarted})
+					progress.emit(ProgressEvent{
+						CheckName: checkName,
+						Stage:     CheckFinished,
+						Elapsed:   time.Since(start),
+						Score:     cached.Score,
+					})
+					resultsCh <- cached
+					return
+				}
+			}
+
 			runner := checker.NewRunner(
 				checkName,
 				repo.URI(),
 				request,
 			)
 
-			resultsCh <- runner.Run(ctx, checkFn)
+			progress.emit(ProgressEvent{CheckName: checkName, Stage: CheckStarted})
+			start = time.Now()
+			result := runCheckWithTimeout(ctx, checkName, checkTimeout, func(checkCtx context.Context) checker.CheckResult {
+				return runner.Run(checkCtx, checkFn)
+			})
+			progress.emit(ProgressEvent{
+				CheckName: checkName,
+				Stage:     CheckFinished,
+				Elapsed:   time.Since(start),
+				Err:       result.Error,
+				Score:     result.Score,
+			})
+			if resultCache != nil && result.Error == nil {
+				// Best-effort: a cache write failure shouldn't fail the check itself.
+				_ = resultCache.Put(repo.URI(), commitSHA, checkName, result)
+			}
+			resultsCh <- result
 		}()
 	}
 	wg.Wait()
 	close(resultsCh)
 }
 
+// runCheckWithTimeout runs run under a derived context bounded by timeout (if
+// positive) and by any deadline already on ctx (such as WithTotalTimeout's),
+// and returns a synthetic, sce.ErrScorecardTimeout-flavored CheckResult the
+// moment either budget is exceeded, rather than blocking the caller on a check
+// that may never return. The abandoned goroutine is left to finish (or hang)
+// on its own; Go provides no way to forcibly cancel it.
+func runCheckWithTimeout(
+	ctx context.Context,
+	checkName string,
+	timeout time.Duration,
+	run func(context.Context) checker.CheckResult,
+) checker.CheckResult {
+	_, hasDeadline := ctx.Deadline()
+	if timeout <= 0 && !hasDeadline {
+		return run(ctx)
+	}
+
+	var checkCtx context.Context
+	var cancel context.CancelFunc
+	budget := "the run's total time budget"
+	if timeout > 0 {
+		checkCtx, cancel = context.WithTimeout(ctx, timeout)
+		budget = fmt.Sprintf("%s budget", timeout)
+	} else {
+		checkCtx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	resultCh := make(chan checker.CheckResult, 1)
+	go func() {
+		resultCh <- run(checkCtx)
+	}()
+
+	select {
+	case result := <-resultCh:
+		return result
+	case <-checkCtx.Done():
+		return checker.CheckResult{
+			Name:  checkName,
+			Error: sce.WithMessage(sce.ErrScorecardTimeout, "check exceeded "+budget),
+		}
+	}
+}
+
 func getRepoCommitHash(r clients.RepoClient) (string, error) {
 	commits, err := r.ListCommits()
 	if err != nil {
@@ -99,7 +181,17 @@ func runScorecard(ctx context.Context,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
 	projectClient packageclient.ProjectPackageClient,
+	progress progressSink,
+	checkTimeout time.Duration,
+	totalTimeout time.Duration,
+	resultCache cache.ResultCache,
 ) (ScorecardResult, error) {
+	if totalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, totalTimeout)
+		defer cancel()
+	}
+
 	if err := repoClient.InitRepo(repo, commitSHA, commitDepth); err != nil {
 		// No need to call sce.WithMessage() since InitRepo will do that for us.
 		//nolint:wrapcheck
@@ -163,7 +255,7 @@ func runScorecard(ctx context.Context,
 
 	// If the user runs probes
 	if len(probesToRun) > 0 {
-		err = runEnabledProbes(request, probesToRun, &ret)
+		err = runEnabledProbes(request, probesToRun, &ret, progress)
 		if err != nil {
 			return ScorecardResult{}, err
 		}
@@ -171,7 +263,7 @@ func runScorecard(ctx context.Context,
 	}
 
 	// If the user runs checks
-	go runEnabledChecks(ctx, repo, request, checksToRun, resultsCh)
+	go runEnabledChecks(ctx, repo, commitSHA, request, checksToRun, resultsCh, progress, checkTimeout, resultCache)
 
 	if os.Getenv(options.EnvVarScorecardExperimental) == "1" {
 		r, path := findConfigFile(repoClient)
@@ -213,6 +305,7 @@ func findConfigFile(rc clients.RepoClient) (io.ReadCloser, string) {
 func runEnabledProbes(request *checker.CheckRequest,
 	probesToRun []string,
 	ret *ScorecardResult,
+	progress progressSink,
 ) error {
 	// Add RawResults to request
 	err := populateRawResults(request, probesToRun, ret)
@@ -227,6 +320,8 @@ func runEnabledProbes(request *checker.CheckRequest,
 			return fmt.Errorf("getting probe %q: %w", probeName, err)
 		}
 		// Run probe
+		progress.emit(ProgressEvent{CheckName: probeName, Stage: CheckStarted})
+		start := time.Now()
 		var findings []finding.Finding
 		if probe.IndependentImplementation != nil {
 			findings, _, err = probe.IndependentImplementation(request)
@@ -234,8 +329,15 @@ func runEnabledProbes(request *checker.CheckRequest,
 			findings, _, err = probe.Implementation(&ret.RawResults)
 		}
 		if err != nil {
+			progress.emit(ProgressEvent{
+				CheckName: probeName,
+				Stage:     CheckFinished,
+				Elapsed:   time.Since(start),
+				Err:       err,
+			})
 			return sce.WithMessage(sce.ErrScorecardInternal, "ending run")
 		}
+		progress.emit(ProgressEvent{CheckName: probeName, Stage: CheckFinished, Elapsed: time.Since(start)})
 		probeFindings = append(probeFindings, findings...)
 	}
 	ret.Findings = probeFindings
@@ -265,6 +367,10 @@ func RunScorecard(ctx context.Context,
 		ciiClient,
 		vulnsClient,
 		projectClient,
+		nil,
+		0,
+		0,
+		nil,
 	)
 }
 
@@ -292,6 +398,10 @@ func ExperimentalRunProbes(ctx context.Context,
 		ciiClient,
 		vulnsClient,
 		projectClient,
+		nil,
+		0,
+		0,
+		nil,
 	)
 }
 
@@ -301,10 +411,14 @@ type runConfig struct {
 	ciiClient     clients.CIIBestPracticesClient
 	projectClient packageclient.ProjectPackageClient
 	ossfuzzClient clients.RepoClient
+	progress      chan<- ProgressEvent
 	checks        []string
 	commit        string
 	probes        []string
 	commitDepth   int
+	checkTimeout  time.Duration
+	totalTimeout  time.Duration
+	resultCache   cache.ResultCache
 }
 
 type Option func(*runConfig) error
@@ -365,6 +479,46 @@ func WithOpenSSFBestPraticesClient(client clients.CIIBestPracticesClient) Option
 	}
 }
 
+// WithProgressSink has Run emit a ProgressEvent into sink as each check (or
+// probe) starts and finishes. Emission never blocks: if sink isn't ready to
+// receive, the event is dropped rather than stalling the check goroutines.
+func WithProgressSink(sink chan<- ProgressEvent) Option {
+	return func(c *runConfig) error {
+		c.progress = sink
+		return nil
+	}
+}
+
+// WithCheckTimeout bounds how long any single check may run. A check that
+// exceeds timeout is recorded as a CheckResult wrapping sce.ErrScorecardTimeout
+// instead of blocking the run indefinitely. Zero (the default) means no bound.
+func WithCheckTimeout(timeout time.Duration) Option {
+	return func(c *runConfig) error {
+		c.checkTimeout = timeout
+		return nil
+	}
+}
+
+// WithTotalTimeout bounds the entire Run call, covering every check. Zero
+// (the default) means no bound.
+func WithTotalTimeout(timeout time.Duration) Option {
+	return func(c *runConfig) error {
+		c.totalTimeout = timeout
+		return nil
+	}
+}
+
+// WithResultCache has runEnabledChecks consult resultCache, keyed by commit
+// SHA, before dispatching each check, and populate it on success. This lets
+// repeated runs against the same commit (CI matrix jobs, the weekly cron
+// scanner) skip network-heavy checks like Vulnerabilities and Fuzzing.
+func WithResultCache(resultCache cache.ResultCache) Option {
+	return func(c *runConfig) error {
+		c.resultCache = resultCache
+		return nil
+	}
+}
+
 func Run(ctx context.Context, repo clients.Repo, opts ...Option) (ScorecardResult, error) {
 	// TODO logger
 	logger := sclog.NewLogger(sclog.InfoLevel)
@@ -408,6 +562,10 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (ScorecardResul
 				return ScorecardResult{}, fmt.Errorf("creating gitlab client: %w", err)
 			}
 		}
+	case *gitearepo.Repo:
+		if c.client == nil {
+			c.client = gitearepo.CreateGiteaRepoClient(ctx, logger)
+		}
 	}
 
 	if !strings.EqualFold(c.commit, clients.HeadSHA) {
@@ -420,5 +578,6 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (ScorecardResul
 	}
 
 	return runScorecard(ctx, repo, c.commit, c.commitDepth, checksToRun, c.probes,
-		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient)
+		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient, c.progress,
+		c.checkTimeout, c.totalTimeout, c.resultCache)
 }
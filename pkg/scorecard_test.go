@@ -0,0 +1,71 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+func TestRunCheckWithTimeoutNoTimeoutRunsToCompletion(t *testing.T) {
+	t.Parallel()
+	want := checker.CheckResult{Name: "Fuzzing", Score: 9}
+
+	got := runCheckWithTimeout(context.Background(), "Fuzzing", 0, func(context.Context) checker.CheckResult {
+		return want
+	})
+	if got.Score != want.Score {
+		t.Errorf("runCheckWithTimeout() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRunCheckWithTimeoutExceeded(t *testing.T) {
+	t.Parallel()
+	blocked := make(chan struct{})
+	defer close(blocked)
+
+	result := runCheckWithTimeout(context.Background(), "Fuzzing", 10*time.Millisecond,
+		func(ctx context.Context) checker.CheckResult {
+			<-blocked // never returns before the test ends
+			return checker.CheckResult{Name: "Fuzzing", Score: 9}
+		})
+
+	if !errors.Is(result.Error, sce.ErrScorecardTimeout) {
+		t.Errorf("runCheckWithTimeout() error = %v, want wrapping sce.ErrScorecardTimeout", result.Error)
+	}
+	if result.Name != "Fuzzing" {
+		t.Errorf("runCheckWithTimeout().Name = %q, want %q", result.Name, "Fuzzing")
+	}
+}
+
+func TestRunCheckWithTimeoutFastCheckBeatsTimeout(t *testing.T) {
+	t.Parallel()
+	want := checker.CheckResult{Name: "Fuzzing", Score: 3}
+
+	got := runCheckWithTimeout(context.Background(), "Fuzzing", time.Second, func(context.Context) checker.CheckResult {
+		return want
+	})
+	if got.Error != nil {
+		t.Errorf("runCheckWithTimeout() error = %v, want nil", got.Error)
+	}
+	if got.Score != want.Score {
+		t.Errorf("runCheckWithTimeout().Score = %d, want %d", got.Score, want.Score)
+	}
+}